@@ -36,13 +36,14 @@ type APIClient struct {
 	cachedDiscovery *disk.CachedDiscoveryClient
 	config          *Config
 	useMetricServer bool
+	rules           *rulesCache
 	mx              sync.Mutex
 }
 
 // InitConnectionOrDie initialize connection from command line args.
 // Checks for connectivity with the api server.
 func InitConnectionOrDie(config *Config) *APIClient {
-	conn := APIClient{config: config}
+	conn := APIClient{config: config, rules: newRulesCache()}
 	conn.useMetricServer = conn.supportsMxServer()
 
 	return &conn
@@ -67,8 +68,21 @@ func makeSAR(ns, gvr string) *authorizationv1.SelfSubjectAccessReview {
 }
 
 // CanI checks if user has access to a certain resource.
+// Access is first resolved from a per-context/namespace rules cache
+// populated from a SelfSubjectRulesReview, falling back to a live
+// SelfSubjectAccessReview per verb when the review is Incomplete.
 func (a *APIClient) CanI(ns, gvr string, verbs []string) (bool, error) {
 	log.Debug().Msgf("AUTH %q:%q -- %v", ns, gvr, verbs)
+
+	if rules, ok := a.cachedRules(ns); ok {
+		if allow, definitive := rules.allows(gvr, verbs); definitive {
+			if !allow {
+				return false, fmt.Errorf("access denied for user on %q:%s", ns, gvr)
+			}
+			return true, nil
+		}
+	}
+
 	sar := makeSAR(ns, gvr)
 	dial := a.DialOrDie().AuthorizationV1().SelfSubjectAccessReviews()
 	for _, v := range verbs {
@@ -87,6 +101,59 @@ func (a *APIClient) CanI(ns, gvr string, verbs []string) (bool, error) {
 	return true, nil
 }
 
+// cachedRules returns the cached access rules for the given namespace,
+// populating the cache from a SelfSubjectRulesReview on first use.
+func (a *APIClient) cachedRules(ns string) (*nsRules, bool) {
+	ctxName, err := a.config.CurrentContextName()
+	if err != nil {
+		return nil, false
+	}
+
+	if r, ok := a.rules.get(ctxName, ns); ok {
+		return r, true
+	}
+
+	r, err := a.fetchRules(ns)
+	if err != nil {
+		log.Warn().Err(err).Msgf("SelfSubjectRulesReview failed for ns %q", ns)
+		return nil, false
+	}
+	a.rules.set(ctxName, ns, r)
+
+	return r, true
+}
+
+func (a *APIClient) fetchRules(ns string) (*nsRules, error) {
+	if ns == "-" {
+		ns = ""
+	}
+	rev := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: ns},
+	}
+	resp, err := a.DialOrDie().AuthorizationV1().SelfSubjectRulesReviews().Create(rev)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNSRules(resp.Status), nil
+}
+
+// CanINonResource checks if user has access to a non-resource URL, eg. /healthz.
+func (a *APIClient) CanINonResource(path string, verbs []string) (bool, error) {
+	rules, ok := a.cachedRules("-")
+	if !ok {
+		return false, fmt.Errorf("unable to resolve access rules for %q", path)
+	}
+
+	for _, v := range verbs {
+		if !rules.allowsNonResource(path, v) {
+			return false, fmt.Errorf("`%s access denied for user on %q", v, path)
+		}
+	}
+
+	return true, nil
+}
+
 // CurrentNamespaceName return namespace name set via either cli arg or cluster config.
 func (a *APIClient) CurrentNamespaceName() (string, error) {
 	return a.config.CurrentNamespaceName()
@@ -251,6 +318,7 @@ func (a *APIClient) reset() {
 	defer a.mx.Unlock()
 
 	a.client, a.dClient, a.nsClient, a.mxsClient = nil, nil, nil, nil
+	a.rules.purge()
 }
 
 func (a *APIClient) supportsMxServer() bool {
@@ -0,0 +1,42 @@
+package client
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FieldManager identifies k9s as the field owner for dry-run and SSA patches.
+const FieldManager = "k9s"
+
+// DryRunPatch resolves server-side defaulting for data against the live
+// object without persisting anything, so callers can diff like with like.
+// force must match whatever the caller's subsequent ApplySSA will use, or a
+// resource with more than one field manager (eg. an HPA-owned
+// spec.replicas) comes back as a 409 conflict here before the user ever
+// gets a chance to apply.
+func (a *APIClient) DryRunPatch(gvr, ns, name string, pt types.PatchType, data []byte, force bool) (*unstructured.Unstructured, error) {
+	return a.dynPatch(gvr, ns, name, pt, data, metav1.PatchOptions{
+		FieldManager: FieldManager,
+		DryRun:       []string{metav1.DryRunAll},
+		Force:        &force,
+	})
+}
+
+// ApplySSA applies data as a server-side-apply patch, optionally forcing
+// through conflicts owned by other field managers.
+func (a *APIClient) ApplySSA(gvr, ns, name string, data []byte, force bool) (*unstructured.Unstructured, error) {
+	return a.dynPatch(gvr, ns, name, types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        &force,
+	})
+}
+
+func (a *APIClient) dynPatch(gvr, ns, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*unstructured.Unstructured, error) {
+	res := a.DynDialOrDie().Resource(NewGVR(gvr).AsGVR())
+	if ns == "" || ns == "-" {
+		return res.Patch(name, pt, data, opts)
+	}
+
+	return res.Namespace(ns).Patch(name, pt, data, opts)
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// cacheExpiry is how long a resolved rules review stays valid before it is
+// re-fetched from the api server.
+const cacheExpiry = 5 * time.Minute
+
+// nsRules tracks the resource and non-resource verbs a user can perform
+// against a given namespace, as resolved from a SelfSubjectRulesReview.
+type nsRules struct {
+	resource    map[string]map[string]struct{} // "group/resource" -> verb set
+	nonResource map[string]map[string]struct{} // path -> verb set
+	incomplete  bool
+	expiresAt   time.Time
+}
+
+// newNSRules flattens a rules review status into lookup tables, expanding
+// "*" wildcards for APIGroups, Resources and Verbs.
+func newNSRules(status authorizationv1.SubjectRulesReviewStatus) *nsRules {
+	r := &nsRules{
+		resource:    make(map[string]map[string]struct{}),
+		nonResource: make(map[string]map[string]struct{}),
+		incomplete:  status.Incomplete,
+		expiresAt:   time.Now().Add(cacheExpiry),
+	}
+
+	for _, rule := range status.ResourceRules {
+		for _, g := range orStar(rule.APIGroups) {
+			for _, res := range orStar(rule.Resources) {
+				r.addVerbs(r.resource, g+"/"+res, rule.Verbs)
+			}
+		}
+	}
+	for _, rule := range status.NonResourceRules {
+		for _, p := range rule.NonResourceURLs {
+			r.addVerbs(r.nonResource, p, rule.Verbs)
+		}
+	}
+
+	return r
+}
+
+func (*nsRules) addVerbs(set map[string]map[string]struct{}, key string, verbs []string) {
+	vs, ok := set[key]
+	if !ok {
+		vs = make(map[string]struct{})
+		set[key] = vs
+	}
+	for _, v := range verbs {
+		vs[v] = struct{}{}
+	}
+}
+
+// allows reports whether the rules grant every verb for the given gvr.
+// definitive is false when the review came back Incomplete and callers
+// should fall back to a live SelfSubjectAccessReview.
+func (r *nsRules) allows(gvr string, verbs []string) (allow, definitive bool) {
+	if r.incomplete {
+		return false, false
+	}
+
+	spec := NewGVR(gvr)
+	res := spec.AsGVR()
+	resource := res.Resource
+	if sub := spec.SubResource(); sub != "" {
+		// RBAC grants subresources against their own resource string, eg.
+		// "pods/log", distinct from the parent "pods" rules.
+		resource = resource + "/" + sub
+	}
+	for _, v := range verbs {
+		if !r.allowsOne(res.Group, resource, v) {
+			return false, true
+		}
+	}
+
+	return true, true
+}
+
+func (r *nsRules) allowsOne(group, resource, verb string) bool {
+	for _, key := range []string{group + "/" + resource, group + "/*", "*/" + resource, "*/*"} {
+		vs, ok := r.resource[key]
+		if !ok {
+			continue
+		}
+		if _, ok := vs["*"]; ok {
+			return true
+		}
+		if _, ok := vs[verb]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *nsRules) allowsNonResource(path, verb string) bool {
+	vs, ok := r.nonResource[path]
+	if !ok {
+		return false
+	}
+	if _, ok := vs["*"]; ok {
+		return true
+	}
+	_, ok = vs[verb]
+
+	return ok
+}
+
+func orStar(ss []string) []string {
+	if len(ss) == 0 {
+		return []string{"*"}
+	}
+
+	return ss
+}
+
+// rulesCache keys cached rules by kube-context+namespace so a context switch
+// or TTL expiry naturally forces a re-fetch.
+type rulesCache struct {
+	mx      sync.RWMutex
+	entries map[string]*nsRules
+}
+
+func newRulesCache() *rulesCache {
+	return &rulesCache{entries: make(map[string]*nsRules)}
+}
+
+func (c *rulesCache) get(ctx, ns string) (*nsRules, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	e, ok := c.entries[ctx+"/"+ns]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (c *rulesCache) set(ctx, ns string, e *nsRules) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.entries[ctx+"/"+ns] = e
+}
+
+func (c *rulesCache) purge() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.entries = make(map[string]*nsRules)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestNewNSRulesWildcards(t *testing.T) {
+	status := authorizationv1.SubjectRulesReviewStatus{
+		ResourceRules: []authorizationv1.ResourceRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			{APIGroups: []string{"apps"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			{Resources: []string{"configmaps"}, Verbs: []string{"get"}}, // no APIGroups -> "*"
+		},
+	}
+	r := newNSRules(status)
+
+	tests := []struct {
+		name     string
+		group    string
+		resource string
+		verb     string
+		want     bool
+	}{
+		{"exact group/resource/verb", "", "pods", "get", true},
+		{"exact group/resource, unlisted verb", "", "pods", "delete", false},
+		{"group with resource wildcard", "apps", "deployments", "update", true},
+		{"apiGroups defaulted to wildcard", "whatever", "configmaps", "get", true},
+		{"unrelated resource", "", "secrets", "get", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.allowsOne(tc.group, tc.resource, tc.verb); got != tc.want {
+				t.Errorf("allowsOne(%q, %q, %q) = %v, want %v", tc.group, tc.resource, tc.verb, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAllowsOneSubresource(t *testing.T) {
+	status := authorizationv1.SubjectRulesReviewStatus{
+		ResourceRules: []authorizationv1.ResourceRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			{APIGroups: []string{""}, Resources: []string{"pods/log"}, Verbs: []string{"get"}},
+		},
+	}
+	r := newNSRules(status)
+
+	// Rules on the base resource must not leak into its subresource.
+	if r.allowsOne("", "pods/log", "list") {
+		t.Error("allowsOne granted pods/log:list from a pods-only rule")
+	}
+	if !r.allowsOne("", "pods/log", "get") {
+		t.Error("allowsOne denied pods/log:get despite an explicit pods/log rule")
+	}
+	if !r.allowsOne("", "pods", "get") {
+		t.Error("allowsOne denied pods:get, base resource rule should be unaffected")
+	}
+}
+
+func TestAllowsNonResource(t *testing.T) {
+	status := authorizationv1.SubjectRulesReviewStatus{
+		NonResourceRules: []authorizationv1.NonResourceRule{
+			{NonResourceURLs: []string{"/healthz"}, Verbs: []string{"get"}},
+			{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"*"}},
+		},
+	}
+	r := newNSRules(status)
+
+	if !r.allowsNonResource("/healthz", "get") {
+		t.Error("expected /healthz:get to be allowed")
+	}
+	if r.allowsNonResource("/healthz", "post") {
+		t.Error("expected /healthz:post to be denied")
+	}
+	if !r.allowsNonResource("/metrics", "post") {
+		t.Error("expected wildcard verb on /metrics to allow post")
+	}
+	if r.allowsNonResource("/other", "get") {
+		t.Error("expected unlisted path to be denied")
+	}
+}
+
+func TestAllowsIncomplete(t *testing.T) {
+	r := newNSRules(authorizationv1.SubjectRulesReviewStatus{Incomplete: true})
+
+	if allow, definitive := r.allows("v1/pods", []string{"get"}); allow || definitive {
+		t.Errorf("allows() on an incomplete review = (%v, %v), want (false, false)", allow, definitive)
+	}
+}
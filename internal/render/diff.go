@@ -0,0 +1,108 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// DiffKind categorizes a single line of a rendered diff.
+type DiffKind int
+
+// DiffKind values.
+const (
+	DiffContext DiffKind = iota
+	DiffAdded
+	DiffRemoved
+)
+
+// DiffLine is one line of a computed diff, tagged with its kind.
+type DiffLine struct {
+	Kind DiffKind
+	Text string
+}
+
+// Differ computes a line-level diff between a desired and a live manifest.
+type Differ struct{}
+
+// Diff returns the line-by-line diff between the desired and live text,
+// using a longest-common-subsequence match to keep unchanged lines as context.
+func (Differ) Diff(desired, live string) []DiffLine {
+	d := strings.Split(strings.TrimRight(desired, "\n"), "\n")
+	l := strings.Split(strings.TrimRight(live, "\n"), "\n")
+
+	lcs := diffLCS(d, l)
+	out := make([]DiffLine, 0, len(d)+len(l))
+	var i, j int
+	for _, m := range lcs {
+		for ; i < m.d; i++ {
+			out = append(out, DiffLine{Kind: DiffAdded, Text: d[i]})
+		}
+		for ; j < m.l; j++ {
+			out = append(out, DiffLine{Kind: DiffRemoved, Text: l[j]})
+		}
+		out = append(out, DiffLine{Kind: DiffContext, Text: d[m.d]})
+		i, j = m.d+1, m.l+1
+	}
+	for ; i < len(d); i++ {
+		out = append(out, DiffLine{Kind: DiffAdded, Text: d[i]})
+	}
+	for ; j < len(l); j++ {
+		out = append(out, DiffLine{Kind: DiffRemoved, Text: l[j]})
+	}
+
+	return out
+}
+
+// Colorer returns the color a diff line should render in: additions green,
+// deletions red, unchanged context dimmed.
+func (Differ) Colorer(k DiffKind) tcell.Color {
+	switch k {
+	case DiffAdded:
+		return tcell.ColorGreen
+	case DiffRemoved:
+		return tcell.ColorRed
+	default:
+		return tcell.ColorGray
+	}
+}
+
+type match struct{ d, l int }
+
+// diffLCS returns the index pairs of matching lines, in order, via the
+// standard O(n*m) longest-common-subsequence dynamic program.
+func diffLCS(d, l []string) []match {
+	n, m := len(d), len(l)
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if d[i] == l[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] >= dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+
+	var ms []match
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case d[i] == l[j]:
+			ms = append(ms, match{d: i, l: j})
+			i++
+			j++
+		case dp[i+1][j] >= dp[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return ms
+}
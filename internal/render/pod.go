@@ -0,0 +1,98 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Pod renders a K8s Pod to screen.
+type Pod struct{}
+
+// ColorerFunc colors a resource row.
+func (Pod) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := tcell.ColorWhite
+		if len(re.Row.Fields) == 0 {
+			return c
+		}
+
+		switch re.Row.Fields[2] {
+		case string(v1.PodRunning), string(v1.PodSucceeded):
+			c = tcell.ColorMediumSpringGreen
+		case string(v1.PodFailed):
+			c = tcell.ColorRed
+		case string(v1.PodPending):
+			c = tcell.ColorYellow
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Pod) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "READY"},
+		Header{Name: "STATUS"},
+		Header{Name: "RESTARTS"},
+		Header{Name: "IP"},
+		Header{Name: "PODIPS", Wide: true},
+		Header{Name: "NODE", Wide: true},
+		Header{Name: "AGE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Pod) Render(o interface{}, gvr string, r *Row) error {
+	po, ok := o.(*v1.Pod)
+	if !ok {
+		return fmt.Errorf("expected *v1.Pod, but got %T", o)
+	}
+
+	ready, restarts := podStatusCounts(po)
+	r.ID = po.Namespace + "/" + po.Name
+	r.Fields = append(r.Fields,
+		po.Name,
+		ready,
+		string(po.Status.Phase),
+		strconv.Itoa(restarts),
+		po.Status.PodIP,
+		podIPs(po),
+		po.Spec.NodeName,
+		toAge(po.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// podIPs joins status.podIPs, falling back to the scalar podIP for clusters
+// that haven't populated the dual-stack list yet.
+func podIPs(po *v1.Pod) string {
+	if len(po.Status.PodIPs) == 0 {
+		return po.Status.PodIP
+	}
+
+	ips := make([]string, 0, len(po.Status.PodIPs))
+	for _, ip := range po.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+
+	return strings.Join(ips, ",")
+}
+
+func podStatusCounts(po *v1.Pod) (string, int) {
+	var ready, restarts int
+	for _, cs := range po.Status.ContainerStatuses {
+		if cs.Ready {
+			ready++
+		}
+		restarts += int(cs.RestartCount)
+	}
+
+	return fmt.Sprintf("%d/%d", ready, len(po.Status.ContainerStatuses)), restarts
+}
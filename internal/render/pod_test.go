@@ -0,0 +1,69 @@
+package render
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestPodIPs(t *testing.T) {
+	tests := []struct {
+		name string
+		po   *v1.Pod
+		want string
+	}{
+		{
+			name: "no podIPs, falls back to scalar podIP",
+			po:   &v1.Pod{Status: v1.PodStatus{PodIP: "10.0.0.1"}},
+			want: "10.0.0.1",
+		},
+		{
+			name: "single podIPs entry",
+			po: &v1.Pod{Status: v1.PodStatus{
+				PodIP:  "10.0.0.1",
+				PodIPs: []v1.PodIP{{IP: "10.0.0.1"}},
+			}},
+			want: "10.0.0.1",
+		},
+		{
+			name: "dual-stack podIPs",
+			po: &v1.Pod{Status: v1.PodStatus{
+				PodIP:  "10.0.0.1",
+				PodIPs: []v1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+			}},
+			want: "10.0.0.1,fd00::1",
+		},
+		{
+			name: "no ip info at all",
+			po:   &v1.Pod{},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := podIPs(tc.po); got != tc.want {
+				t.Errorf("podIPs() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPodStatusCounts(t *testing.T) {
+	po := &v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Ready: true, RestartCount: 2},
+				{Ready: false, RestartCount: 1},
+			},
+		},
+	}
+
+	ready, restarts := podStatusCounts(po)
+	if ready != "1/2" {
+		t.Errorf("podStatusCounts() ready = %q, want %q", ready, "1/2")
+	}
+	if restarts != 3 {
+		t.Errorf("podStatusCounts() restarts = %d, want %d", restarts, 3)
+	}
+}
@@ -0,0 +1,33 @@
+package ui
+
+import "strings"
+
+// fieldSelectorPrefix is an explicit opt-in marker for field-selector filter
+// mode, eg. "f:status.phase=Running".
+const fieldSelectorPrefix = "f:"
+
+// fieldPathPrefixes let users type a field selector without the explicit
+// f: marker for the common top-level field paths, eg. "spec.nodeName=node-1".
+var fieldPathPrefixes = []string{"spec.", "status.", "metadata."}
+
+// IsFieldSelector checks if the supplied filter command denotes a field
+// selector, either via the explicit "f:" marker or one of the well-known
+// field path prefixes.
+func IsFieldSelector(s string) bool {
+	if strings.HasPrefix(s, fieldSelectorPrefix) {
+		return true
+	}
+	for _, p := range fieldPathPrefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TrimFieldSelector strips the explicit "f:" marker, if present, leaving a
+// selector string ready to hand to the api server.
+func TrimFieldSelector(s string) string {
+	return strings.TrimPrefix(s, fieldSelectorPrefix)
+}
@@ -0,0 +1,40 @@
+package ui
+
+import "testing"
+
+func TestIsFieldSelector(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"f:status.phase=Running", true},
+		{"spec.nodeName=node-1", true},
+		{"status.phase=Running", true},
+		{"metadata.name=foo", true},
+		{"app=foo", false},
+		{"", false},
+	}
+
+	for _, tc := range tests {
+		if got := IsFieldSelector(tc.in); got != tc.want {
+			t.Errorf("IsFieldSelector(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTrimFieldSelector(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"f:status.phase=Running", "status.phase=Running"},
+		{"spec.nodeName=node-1", "spec.nodeName=node-1"},
+		{"", ""},
+	}
+
+	for _, tc := range tests {
+		if got := TrimFieldSelector(tc.in); got != tc.want {
+			t.Errorf("TrimFieldSelector(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Package bulk drives actions against a batch of marked resources (delete,
+// label, annotate, scale, cordon) concurrently through the dynamic client.
+package bulk
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// maxWorkers bounds the number of concurrent patch/delete requests in flight.
+const maxWorkers = 10
+
+// Target identifies a single marked row to act upon.
+type Target struct {
+	GVR       string
+	Namespace string
+	Name      string
+}
+
+// Result reports the outcome of a bulk operation against one target.
+type Result struct {
+	Target Target
+	Err    error
+}
+
+// PatchFn builds the patch payload for a target. A nil PatchFn means the
+// op is a straight delete.
+type PatchFn func(Target) (types.PatchType, []byte, error)
+
+// Op describes a bulk action: the verb it requires and how it patches a target.
+type Op struct {
+	Name    string
+	Verb    string
+	PatchFn PatchFn
+}
+
+// Runner executes an Op concurrently against a set of marked targets.
+type Runner struct {
+	factory *watch.Factory
+}
+
+// NewRunner returns a new bulk operation runner.
+func NewRunner(f *watch.Factory) *Runner {
+	return &Runner{factory: f}
+}
+
+// Filter splits targets into ones the user is authorized to run op against
+// and ones that must be reported as denied up front.
+func (r *Runner) Filter(op Op, tt []Target) (allowed, denied []Target) {
+	for _, t := range tt {
+		ok, err := r.factory.Client().CanI(t.Namespace, t.GVR, []string{op.Verb})
+		if err != nil || !ok {
+			denied = append(denied, t)
+			continue
+		}
+		allowed = append(allowed, t)
+	}
+
+	return allowed, denied
+}
+
+// Run fires op against every target concurrently, bounded by maxWorkers, and
+// returns a Result per target in the same order as tt.
+func (r *Runner) Run(op Op, tt []Target) []Result {
+	res := make([]Result, len(tt))
+	sem := make(chan struct{}, maxWorkers)
+
+	var wg sync.WaitGroup
+	for i, t := range tt {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, t Target) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res[i] = Result{Target: t, Err: r.apply(op, t)}
+		}(i, t)
+	}
+	wg.Wait()
+
+	return res
+}
+
+func (r *Runner) apply(op Op, t Target) error {
+	res := r.resourceFor(t)
+
+	var err error
+	switch {
+	case op.PatchFn == nil:
+		err = res.Delete(t.Name, &metav1.DeleteOptions{})
+	default:
+		var pt types.PatchType
+		var patch []byte
+		if pt, patch, err = op.PatchFn(t); err == nil {
+			// PatchOptions is required here, same as DeleteOptions above --
+			// the dynamic client has no zero-value-friendly overload.
+			_, err = res.Patch(t.Name, pt, patch, metav1.PatchOptions{})
+		}
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Bulk %s failed for %s %s/%s", op.Name, t.GVR, t.Namespace, t.Name)
+	}
+
+	return err
+}
+
+func (r *Runner) resourceFor(t Target) dynamic.ResourceInterface {
+	gvr := client.NewGVR(t.GVR).AsGVR()
+	nri := r.factory.Client().DynDialOrDie().Resource(gvr)
+	if t.Namespace == "" || t.Namespace == "-" {
+		return nri
+	}
+
+	return nri.Namespace(t.Namespace)
+}
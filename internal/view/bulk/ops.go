@@ -0,0 +1,113 @@
+package bulk
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// DeleteOp removes each marked target outright.
+var DeleteOp = Op{Name: "Delete", Verb: "delete"}
+
+// LabelOp merges the given labels into each marked target.
+func LabelOp(labels map[string]string) Op {
+	return mapFieldOp("Label", []string{"metadata", "labels"}, labels)
+}
+
+// AnnotateOp merges the given annotations into each marked target.
+func AnnotateOp(annotations map[string]string) Op {
+	return mapFieldOp("Annotate", []string{"metadata", "annotations"}, annotations)
+}
+
+// ScaleOp sets replicas on marked workloads (Deployments, StatefulSets, ReplicaSets).
+func ScaleOp(replicas int32) Op {
+	return fieldOp("Scale", []string{"spec", "replicas"}, replicas)
+}
+
+// CordonOp marks nodes unschedulable.
+var CordonOp = fieldOp("Cordon", []string{"spec", "unschedulable"}, true)
+
+// supportsStrategicMerge reports whether a target's GVK is a built-in type
+// known to the client-go scheme. CRD-backed resources (Applications,
+// VirtualServices, ...) aren't registered there and have no client-side
+// strategic-merge schema, so they need a plain JSON patch instead.
+func supportsStrategicMerge(gvr string) bool {
+	return scheme.Scheme.Recognizes(client.NewGVR(gvr).AsGVK())
+}
+
+// fieldOp builds an Op that sets a single field, picking a strategic-merge
+// patch when the target supports one and falling back to a JSON patch
+// (RFC 6902) otherwise, per target -- not per Op.
+func fieldOp(name string, path []string, value interface{}) Op {
+	return Op{
+		Name: name,
+		Verb: "patch",
+		PatchFn: func(t Target) (types.PatchType, []byte, error) {
+			if supportsStrategicMerge(t.GVR) {
+				patch, err := json.Marshal(nest(path, value))
+				return types.StrategicMergePatchType, patch, err
+			}
+
+			patch, err := json.Marshal([]map[string]interface{}{
+				{"op": "add", "path": "/" + strings.Join(path, "/"), "value": value},
+			})
+			return types.JSONPatchType, patch, err
+		},
+	}
+}
+
+// mapFieldOp builds an Op that merges entries into a map field (labels,
+// annotations). A strategic-merge patch already merges maps natively, but a
+// JSON patch "add" against an existing map member *replaces* it (RFC 6902),
+// so the JSON-patch branch instead emits one "add" per key at its own
+// pointer -- that's what actually merges rather than wiping every key the
+// user didn't mention.
+func mapFieldOp(name string, path []string, values map[string]string) Op {
+	return Op{
+		Name: name,
+		Verb: "patch",
+		PatchFn: func(t Target) (types.PatchType, []byte, error) {
+			if supportsStrategicMerge(t.GVR) {
+				nested := make(map[string]interface{}, len(values))
+				for k, v := range values {
+					nested[k] = v
+				}
+				patch, err := json.Marshal(nest(path, nested))
+				return types.StrategicMergePatchType, patch, err
+			}
+
+			base := strings.Join(path, "/")
+			ops := make([]map[string]interface{}, 0, len(values))
+			for k, v := range values {
+				ops = append(ops, map[string]interface{}{
+					"op":    "add",
+					"path":  "/" + base + "/" + escapeJSONPointer(k),
+					"value": v,
+				})
+			}
+			patch, err := json.Marshal(ops)
+			return types.JSONPatchType, patch, err
+		},
+	}
+}
+
+// escapeJSONPointer escapes a map key for use as a JSON Pointer (RFC 6901)
+// reference token: "~" must come first so it doesn't clobber the "/" escape.
+func escapeJSONPointer(s string) string {
+	s = strings.ReplaceAll(s, "~", "~0")
+	return strings.ReplaceAll(s, "/", "~1")
+}
+
+// nest turns a field path and a leaf value into the nested map a strategic
+// merge patch expects, eg. ["spec", "replicas"], 3 -> {"spec":{"replicas":3}}.
+func nest(path []string, value interface{}) map[string]interface{} {
+	m := map[string]interface{}{path[len(path)-1]: value}
+	for i := len(path) - 2; i >= 0; i-- {
+		m = map[string]interface{}{path[i]: m}
+	}
+
+	return m
+}
@@ -0,0 +1,200 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// lastAppliedAnno is the annotation kubectl apply stashes the desired
+// manifest under.
+const lastAppliedAnno = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Diff renders a live-vs-desired manifest comparison for a single resource,
+// reachable as a key action on any selected row.
+type Diff struct {
+	*tview.TextView
+
+	app  *App
+	gvr  string
+	path string
+
+	// src is where the desired manifest comes from: empty (use the
+	// last-applied-configuration annotation), "clipboard", or a file path.
+	// It never changes after construction.
+	src string
+	// desired is the resolved manifest text from the most recent refresh,
+	// used by applyCmd. It must not be confused with src above.
+	desired string
+	// force, when set, forces the dry-run and real apply through conflicts
+	// held by other field managers. Off by default -- a conflict is
+	// surfaced as an error rather than silently steamrolled.
+	force bool
+}
+
+// NewDiff returns a new diff view for the resource at path. src is either
+// empty (use the last-applied-configuration annotation), "clipboard", or a
+// file path holding the desired manifest.
+func NewDiff(gvr, path, src string) *Diff {
+	return &Diff{
+		TextView: tview.NewTextView().SetDynamicColors(true).SetWrap(false),
+		gvr:      gvr,
+		path:     path,
+		src:      src,
+	}
+}
+
+// Init initializes the view.
+func (d *Diff) Init(ctx context.Context) {
+	d.app = mustExtractApp(ctx)
+	d.SetBorder(true)
+	d.refreshTitle()
+	d.bindKeys()
+
+	if err := d.refresh(); err != nil {
+		d.app.Flash().Err(err)
+	}
+}
+
+func (d *Diff) Start()       {}
+func (d *Diff) Stop()        {}
+func (d *Diff) Name() string { return "Diff" }
+
+func (d *Diff) bindKeys() {
+	d.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyCtrlS:
+			return d.applyCmd(evt)
+		case tcell.KeyCtrlR:
+			if err := d.refresh(); err != nil {
+				d.app.Flash().Err(err)
+			}
+			return nil
+		case tcell.KeyCtrlF:
+			d.force = !d.force
+			d.refreshTitle()
+			if err := d.refresh(); err != nil {
+				d.app.Flash().Err(err)
+			}
+			return nil
+		default:
+			return evt
+		}
+	})
+}
+
+// refreshTitle repaints the border title to reflect the current force state.
+func (d *Diff) refreshTitle() {
+	if d.force {
+		d.SetTitle(fmt.Sprintf(" Diff: %s [force] ", d.path))
+		return
+	}
+	d.SetTitle(fmt.Sprintf(" Diff: %s ", d.path))
+}
+
+// refresh fetches the live object, resolves the desired manifest, normalizes
+// the desired manifest through a server-side dry-run apply, and repaints the
+// diff. The live object is diffed as fetched; it is not dry-run.
+func (d *Diff) refresh() error {
+	obj, err := d.app.Factory().Get(d.gvr, d.path, nil)
+	if err != nil {
+		return err
+	}
+	live, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected *unstructured.Unstructured, but got %T", obj)
+	}
+
+	desired, err := d.resolveDesired(live)
+	if err != nil {
+		return err
+	}
+
+	ns, n := splitPath(d.path)
+	dry, err := d.app.Factory().Client().DryRunPatch(d.gvr, ns, n, types.ApplyPatchType, []byte(desired), d.force)
+	if err != nil {
+		return err
+	}
+
+	liveYAML, err := yaml.Marshal(live.Object)
+	if err != nil {
+		return err
+	}
+	dryYAML, err := yaml.Marshal(dry.Object)
+	if err != nil {
+		return err
+	}
+
+	d.desired = desired
+	d.paint(render.Differ{}.Diff(string(dryYAML), string(liveYAML)))
+
+	return nil
+}
+
+// resolveDesired returns the already-supplied manifest (file/clipboard) or
+// falls back to the live object's last-applied-configuration annotation.
+func (d *Diff) resolveDesired(live *unstructured.Unstructured) (string, error) {
+	switch d.src {
+	case "clipboard":
+		return clipboard.ReadAll()
+	case "":
+		anno := live.GetAnnotations()[lastAppliedAnno]
+		if anno == "" {
+			return "", fmt.Errorf("no %s annotation and no manifest supplied", lastAppliedAnno)
+		}
+		return anno, nil
+	default:
+		b, err := ioutil.ReadFile(d.src)
+		return string(b), err
+	}
+}
+
+func (d *Diff) paint(lines []render.DiffLine) {
+	var b strings.Builder
+	for _, l := range lines {
+		switch l.Kind {
+		case render.DiffAdded:
+			b.WriteString("[green::]+ " + tview.Escape(l.Text) + "[-::]\n")
+		case render.DiffRemoved:
+			b.WriteString("[red::]- " + tview.Escape(l.Text) + "[-::]\n")
+		default:
+			b.WriteString("[gray::]  " + tview.Escape(l.Text) + "[-::]\n")
+		}
+	}
+	d.SetText(b.String())
+}
+
+// applyCmd applies the desired manifest via server-side-apply, forcing
+// through conflicting field managers only when force is toggled on.
+func (d *Diff) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns, n := splitPath(d.path)
+	if _, err := d.app.Factory().Client().ApplySSA(d.gvr, ns, n, []byte(d.desired), d.force); err != nil {
+		d.app.Flash().Err(err)
+		return nil
+	}
+	d.app.Flash().Infof("Applied %s", d.path)
+
+	if err := d.refresh(); err != nil {
+		d.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func splitPath(path string) (string, string) {
+	idx := strings.LastIndex(path, "/")
+	if idx < 0 {
+		return "-", path
+	}
+
+	return path[:idx], path[idx+1:]
+}
@@ -2,8 +2,11 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/bulk"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -11,8 +14,9 @@ import (
 type Table struct {
 	*ui.Table
 
-	app      *App
-	filterFn func(string)
+	app           *App
+	filterFn      func(string)
+	fieldFilterFn func(string)
 }
 
 func NewTable(title string) *Table {
@@ -28,6 +32,7 @@ func (t *Table) Init(ctx context.Context) {
 
 	t.SearchBuff().AddListener(t.app.Cmd())
 	t.SearchBuff().AddListener(t)
+	t.fieldFilterFn = t.setServerFieldSelector
 	t.bindKeys()
 }
 
@@ -62,6 +67,25 @@ func (t *Table) setFilterFn(fn func(string)) {
 	}
 }
 
+// setServerFieldSelector pushes a field selector down to the informer
+// backing this table's resource, so filtering happens server-side instead
+// of scanning every row client-side. Invalid selectors surface as a flash
+// error instead of silently emptying the table.
+func (t *Table) setServerFieldSelector(sel string) {
+	if err := t.app.Factory().SetFieldSelector(t.GetNamespace(), t.GVR(), sel); err != nil {
+		t.app.Flash().Err(err)
+		return
+	}
+	t.Refresh()
+}
+
+// scalableGVRs are the workload kinds that support a /scale subresource.
+var scalableGVRs = map[string]bool{
+	"apps/v1/deployments":  true,
+	"apps/v1/statefulsets": true,
+	"apps/v1/replicasets":  true,
+}
+
 func (t *Table) bindKeys() {
 	t.AddActions(ui.KeyActions{
 		ui.KeySpace:         ui.NewKeyAction("Mark", t.markCmd, true),
@@ -76,7 +100,156 @@ func (t *Table) bindKeys() {
 		ui.KeyShiftI:        ui.NewKeyAction("Invert", t.SortInvertCmd, false),
 		ui.KeyShiftN:        ui.NewKeyAction("Sort Name", t.SortColCmd(0), false),
 		ui.KeyShiftA:        ui.NewKeyAction("Sort Age", t.SortColCmd(-1), false),
+		tcell.KeyCtrlD:      ui.NewKeyAction("Bulk Delete", t.bulkDeleteCmd, true),
+		tcell.KeyCtrlL:      ui.NewKeyAction("Bulk Label", t.bulkLabelCmd, true),
+		tcell.KeyCtrlA:      ui.NewKeyAction("Bulk Annotate", t.bulkAnnotateCmd, true),
+		ui.KeyD:             ui.NewKeyAction("Diff", t.diffCmd, true),
 	})
+
+	// Scale and cordon are resource-specific: only surface the key action
+	// (and its footer hint) on the kinds they actually apply to, rather than
+	// on every table that embeds this type.
+	if scalableGVRs[t.GVR()] {
+		t.AddActions(ui.KeyActions{
+			tcell.KeyCtrlR: ui.NewKeyAction("Bulk Scale", t.bulkScaleCmd, true),
+		})
+	}
+	if t.GVR() == "v1/nodes" {
+		t.AddActions(ui.KeyActions{
+			tcell.KeyCtrlN: ui.NewKeyAction("Bulk Cordon", t.bulkCordonCmd, true),
+		})
+	}
+}
+
+// markedTargets turns the currently marked rows into bulk op targets.
+func (t *Table) markedTargets() []bulk.Target {
+	ids := t.GetMarkedRows()
+	tt := make([]bulk.Target, 0, len(ids))
+	for _, id := range ids {
+		ns, n := namespaced(id)
+		tt = append(tt, bulk.Target{GVR: t.GVR(), Namespace: ns, Name: n})
+	}
+
+	return tt
+}
+
+func namespaced(id string) (string, string) {
+	idx := strings.LastIndex(id, "/")
+	if idx < 0 {
+		return "-", id
+	}
+
+	return id[:idx], id[idx+1:]
+}
+
+// runBulk authorizes, confirms and fires op against every marked row.
+func (t *Table) runBulk(op bulk.Op, prompt string) {
+	tt := t.markedTargets()
+	if len(tt) == 0 {
+		t.app.Flash().Warn("No rows marked!")
+		return
+	}
+
+	runner := bulk.NewRunner(t.app.Factory())
+	allowed, denied := runner.Filter(op, tt)
+	for _, d := range denied {
+		t.app.Flash().Errf("Not authorized to %s %s %s/%s", op.Verb, d.GVR, d.Namespace, d.Name)
+	}
+	if len(allowed) == 0 {
+		return
+	}
+
+	// markID rebuilds the row id a target came from: namespaced maps an
+	// unqualified id to "-", so those are re-marked by name alone.
+	markID := func(tg bulk.Target) string {
+		if tg.Namespace == "-" {
+			return tg.Name
+		}
+		return tg.Namespace + "/" + tg.Name
+	}
+
+	t.app.Prompt().ShowConfirm(
+		fmt.Sprintf("%s %d marked row(s)?", prompt, len(allowed)),
+		func() {
+			t.ClearMarks()
+			// Denied targets never ran, so they "failed" same as an
+			// errored one -- leave them marked so the user can retry
+			// after fixing access instead of losing the selection.
+			for _, d := range denied {
+				t.Mark(markID(d))
+			}
+			for _, r := range runner.Run(op, allowed) {
+				if r.Err == nil {
+					continue
+				}
+				t.app.Flash().Errf("%s failed for %s: %s", op.Name, r.Target.Name, r.Err)
+				t.Mark(markID(r.Target))
+			}
+			t.Refresh()
+		},
+	)
+}
+
+func (t *Table) bulkDeleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.runBulk(bulk.DeleteOp, "Delete")
+	return nil
+}
+
+func (t *Table) bulkLabelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.app.Prompt().ShowInput("Label (k=v,...)", func(s string) {
+		t.runBulk(bulk.LabelOp(parsePairs(s)), "Label")
+	})
+	return nil
+}
+
+func (t *Table) bulkAnnotateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.app.Prompt().ShowInput("Annotate (k=v,...)", func(s string) {
+		t.runBulk(bulk.AnnotateOp(parsePairs(s)), "Annotate")
+	})
+	return nil
+}
+
+func (t *Table) bulkScaleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.app.Prompt().ShowInput("Replicas", func(s string) {
+		var n int32
+		if _, err := fmt.Sscanf(s, "%d", &n); err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.runBulk(bulk.ScaleOp(n), "Scale")
+	})
+	return nil
+}
+
+func (t *Table) bulkCordonCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.runBulk(bulk.CordonOp, "Cordon")
+	return nil
+}
+
+// diffCmd opens a live-vs-desired diff view for the currently selected row.
+func (t *Table) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !t.RowSelected() {
+		return evt
+	}
+	if err := t.app.inject(NewDiff(t.GVR(), t.GetSelectedItem(), ""), false); err != nil {
+		t.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// parsePairs turns a comma-separated "k=v,k2=v2" string into a map.
+func parsePairs(s string) map[string]string {
+	m := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		m[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return m
 }
 
 func (t *Table) markCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -105,9 +278,13 @@ func (t *Table) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	t.SearchBuff().SetActive(false)
 	cmd := t.SearchBuff().String()
-	if ui.IsLabelSelector(cmd) && t.filterFn != nil {
+	switch {
+	case ui.IsLabelSelector(cmd) && t.filterFn != nil:
 		t.filterFn(ui.TrimLabelSelector(cmd))
 		return nil
+	case ui.IsFieldSelector(cmd) && t.fieldFilterFn != nil:
+		t.fieldFilterFn(ui.TrimFieldSelector(cmd))
+		return nil
 	}
 	t.Refresh()
 
@@ -128,8 +305,11 @@ func (t *Table) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	if ui.IsLabelSelector(t.SearchBuff().String()) {
+	switch {
+	case ui.IsLabelSelector(t.SearchBuff().String()):
 		t.filterFn("")
+	case ui.IsFieldSelector(t.SearchBuff().String()):
+		t.fieldFilterFn("")
 	}
 	t.app.Flash().Info("Clearing filter...")
 	t.SearchBuff().Reset()
@@ -147,4 +327,4 @@ func (t *Table) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.SearchBuff().SetActive(true)
 
 	return nil
-}
\ No newline at end of file
+}
@@ -1,70 +1,142 @@
 package watch
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
-// Factory - *factories(ns) -> *informers
+// Factory - *factories(ns,gvr) -> *informers
 const (
 	defaultResync = 10 * time.Minute
 	allNamespaces = ""
 	clusterScope  = "-"
+
+	// defaultMaxIdleInformers caps how many never-explicitly-released
+	// informers (ie. ones only touched by List/Get, which never take out a
+	// ref) are kept warm (LRU) at once.
+	defaultMaxIdleInformers = 20
+	// defaultIdleTTL is how long a never-explicitly-released informer
+	// survives before it's torn down regardless of the idle cap. Informers
+	// held via a Handle are unaffected: they're stopped the moment their
+	// last Handle.Release() runs.
+	defaultIdleTTL = 2 * time.Minute
+	// reapInterval is how often the idle reaper sweeps informerEntries.
+	reapInterval = 30 * time.Second
 )
 
+// informerEntry is a single (ns, gvr) informer along with its own
+// cancelable context and ref-count bookkeeping.
+type informerEntry struct {
+	gi       informers.GenericInformer
+	cancel   context.CancelFunc
+	refs     int
+	lastUsed time.Time
+	synced   time.Duration
+	errs     int64
+}
+
+// Handle is a ref-counted lease on a running informer for a given (ns, gvr).
+// Consumers that outlive a single List/Get call (eg. a view that stays on
+// screen) should hold on to a Handle and Release it once they're done
+// watching, so the informer can be torn down instead of leaking a watch
+// connection on the apiserver.
+type Handle struct {
+	Informer informers.GenericInformer
+
+	factory *Factory
+	key     string
+	once    sync.Once
+}
+
+// Release gives up this consumer's interest in the informer. Once the last
+// Handle for a (ns, gvr) is released, the informer is stopped and removed
+// from the factory immediately.
+func (h *Handle) Release() {
+	h.once.Do(func() {
+		h.factory.release(h.key)
+	})
+}
+
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
-	client     client.Connection
-	stopChan   chan struct{}
-	activeNS   string
-	forwarders Forwarders
+	mx             sync.Mutex
+	client         client.Connection
+	informers      map[string]*informerEntry
+	fieldSelectors map[string]string // "ns::gvr" -> active field selector
+	maxIdle        int
+	idleTTL        time.Duration
+	activeNS       string
+	forwarders     Forwarders
+	stopChan       chan struct{}
 }
 
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
-		client:     client,
-		stopChan:   make(chan struct{}),
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
-		forwarders: NewForwarders(),
+		client:         client,
+		stopChan:       make(chan struct{}),
+		informers:      make(map[string]*informerEntry),
+		fieldSelectors: make(map[string]string),
+		forwarders:     NewForwarders(),
+		maxIdle:        defaultMaxIdleInformers,
+		idleTTL:        defaultIdleTTL,
 	}
 }
 
+// SetMaxIdleInformers overrides how many unreferenced informers may stay
+// warm before the LRU reaper starts evicting them.
+func (f *Factory) SetMaxIdleInformers(n int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.maxIdle = n
+}
+
 func (f *Factory) Dump() {
 	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	for key, e := range f.informers {
+		log.Debug().Msgf("  Informer %q (refs=%d)", key, e.refs)
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
 
 func (f *Factory) Debug(gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	inf := f.factories[allNamespaces].ForResource(toGVR(gvr))
-	for i, k := range inf.Informer().GetStore().ListKeys() {
+	h := f.ForResource(allNamespaces, gvr)
+	defer h.Release()
+	for i, k := range h.Informer.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
 }
 
 func (f *Factory) Show(ns, gvr string) {
 	log.Debug().Msgf("----------- SHOW FACTORIES %q -------------", ns)
-	inf := f.ForResource(ns, gvr)
-	for _, k := range inf.Informer().GetStore().ListKeys() {
+	h := f.ForResource(ns, gvr)
+	defer h.Release()
+	for _, k := range h.Informer.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("  Key: %s", k)
 	}
 }
 
+// List returns the cached resources for a given (ns, gvr), starting the
+// backing informer if needed. The informer is not released immediately: it
+// stays warm for the idle TTL so back-to-back refreshes reuse it.
 func (f *Factory) List(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
 	auth, err := f.Client().CanI(ns, gvr, []string{"list"})
 	if err != nil {
@@ -74,9 +146,9 @@ func (f *Factory) List(gvr, ns string, sel labels.Selector) ([]runtime.Object, e
 		return nil, fmt.Errorf("User has insufficient access to list %s", gvr)
 	}
 
-	inf := f.ForResource(ns, gvr)
-	if inf == nil {
-		return nil, fmt.Errorf("No resource for GVR %s", gvr)
+	inf, err := f.touch(ns, gvr)
+	if err != nil {
+		return nil, err
 	}
 	if ns == clusterScope {
 		return inf.Lister().List(sel)
@@ -85,8 +157,8 @@ func (f *Factory) List(gvr, ns string, sel labels.Selector) ([]runtime.Object, e
 	return inf.Lister().ByNamespace(ns).List(sel)
 }
 
-func (f *Factory) Get(gvr, path string, sel labels.Selector) (runtime.Object, error) {
-	ns, n := namespaced(path)
+func (f *Factory) Get(gvr, fpath string, sel labels.Selector) (runtime.Object, error) {
+	ns, n := namespaced(fpath)
 	auth, err := f.Client().CanI(ns, gvr, []string{"get"})
 	if err != nil {
 		return nil, err
@@ -95,35 +167,65 @@ func (f *Factory) Get(gvr, path string, sel labels.Selector) (runtime.Object, er
 		return nil, fmt.Errorf("User has insufficient access to get %s", gvr)
 	}
 
-	inf := f.ForResource(ns, gvr)
-	if inf == nil {
-		return nil, fmt.Errorf("No resource for GVR %s", gvr)
+	inf, err := f.touch(ns, gvr)
+	if err != nil {
+		return nil, err
 	}
 	if ns == clusterScope {
 		return inf.Lister().Get(n)
 	}
 
-	log.Debug().Msgf("GET %q--%q:%q", gvr, ns, path)
+	log.Debug().Msgf("GET %q--%q:%q", gvr, ns, fpath)
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
+// touch ensures an informer is running for (ns, gvr) and bumps its
+// last-used time, without taking out a ref -- List/Get are transient callers,
+// not long-lived watchers, so the idle reaper is what eventually reclaims it.
+// It transparently picks up whatever field selector was last set via
+// SetFieldSelector for this (ns, gvr), so filtered views keep hitting the
+// filtered informer across refreshes.
+func (f *Factory) touch(ns, gvr string) (informers.GenericInformer, error) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	fieldSel := f.fieldSelectors[nsGVRKey(ns, gvr)]
+	e := f.ensure(ns, gvr, fieldSel)
+	e.lastUsed = time.Now()
+
+	return e.gi, nil
+}
+
 func (f *Factory) WaitForCacheSync() {
-	for _, fac := range f.factories {
-		fac.WaitForCacheSync(f.stopChan)
+	f.mx.Lock()
+	entries := make([]*informerEntry, 0, len(f.informers))
+	for _, e := range f.informers {
+		entries = append(entries, e)
+	}
+	f.mx.Unlock()
+
+	for _, e := range entries {
+		start := time.Now()
+		cache.WaitForCacheSync(f.stopChan, e.gi.Informer().HasSynced)
+		e.synced = time.Since(start)
 	}
 }
 
 func (f *Factory) Init() {
-	f.Start(f.stopChan)
+	go f.reapLoop()
 }
 
 func (f *Factory) Terminate() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
 	if f.stopChan != nil {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for k, e := range f.informers {
+		e.cancel()
+		delete(f.informers, k)
 	}
 	f.forwarders.DeleteAll()
 }
@@ -152,67 +254,249 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 	return fwd, ok
 }
 
-// Start initializes the informers until caller cancels the context.
-func (f *Factory) Start(stopChan chan struct{}) {
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(stopChan)
+func (f *Factory) SetActive(ns string) {
+	f.activeNS = ns
+}
+
+// ForResource returns a ref-counted Handle on the informer for (ns, gvr),
+// starting it with its own cancelable context if it isn't already running.
+// Callers must Release the Handle once they stop watching.
+func (f *Factory) ForResource(ns, gvr string) *Handle {
+	h, _ := f.ForResourceWithSelector(ns, gvr, "")
+	return h
+}
+
+// ForResourceWithSelector is like ForResource but additionally pushes a
+// field selector down to the informer's list/watch calls. The selector is
+// validated against the live server before the informer is (re)built, so an
+// invalid field surfaces an error here rather than silently listing nothing.
+func (f *Factory) ForResourceWithSelector(ns, gvr, fieldSel string) (*Handle, error) {
+	if fieldSel != "" {
+		if err := f.validateFieldSelector(ns, gvr, fieldSel); err != nil {
+			return nil, err
+		}
 	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	e := f.ensure(ns, gvr, fieldSel)
+	e.refs++
+	e.lastUsed = time.Now()
+
+	return &Handle{factory: f, key: entryKey(ns, gvr, fieldSel), Informer: e.gi}, nil
 }
 
-// BOZO!! Check ns access for resource??
-func (f *Factory) SetActive(ns string) {
-	if !f.isClusterWide() {
-		f.ensureFactory(ns)
+// SetFieldSelector pushes a server-side field selector down to the informer
+// backing (ns, gvr), validating it first and rebuilding the informer only if
+// the selector actually changed. Pass an empty selector to go back to an
+// unfiltered informer.
+func (f *Factory) SetFieldSelector(ns, gvr, fieldSel string) error {
+	if fieldSel != "" {
+		if err := f.validateFieldSelector(ns, gvr, fieldSel); err != nil {
+			return err
+		}
 	}
-	f.activeNS = ns
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	key := nsGVRKey(ns, gvr)
+	oldSel := f.fieldSelectors[key]
+	if oldSel == fieldSel {
+		return nil
+	}
+
+	// Only tear down the old informer outright if nothing still references
+	// it; otherwise it is stopped when its last Handle is released.
+	if e, ok := f.informers[entryKey(ns, gvr, oldSel)]; ok && e.refs == 0 {
+		f.evict(entryKey(ns, gvr, oldSel))
+	}
+	if fieldSel == "" {
+		delete(f.fieldSelectors, key)
+	} else {
+		f.fieldSelectors[key] = fieldSel
+	}
+	f.ensure(ns, gvr, fieldSel)
+
+	return nil
 }
 
-func (f *Factory) isClusterWide() bool {
-	_, ok := f.factories[allNamespaces]
-	return ok
+// validateFieldSelector issues a 1-item dry List straight at the api server
+// so an invalid field surfaces as an error instead of an empty table.
+func (f *Factory) validateFieldSelector(ns, gvr, fieldSel string) error {
+	res := f.client.DynDialOrDie().Resource(client.NewGVR(gvr).AsGVR())
+	opts := metav1.ListOptions{FieldSelector: fieldSel, Limit: 1}
+
+	var err error
+	if ns == "" || ns == clusterScope {
+		_, err = res.List(opts)
+	} else {
+		_, err = res.Namespace(ns).List(opts)
+	}
+
+	return err
 }
 
-func (f *Factory) preload(ns string) {
-	f.ForResource(ns, "v1/pods")
-	f.ForResource(allNamespaces, "apiextensions.k8s.io/v1beta1/customresourcedefinitions")
-	f.ForResource(clusterScope, "rbac.authorization.k8s.io/v1/clusterroles")
-	f.ForResource(allNamespaces, "rbac.authorization.k8s.io/v1/roles")
+// Preload starts the informer for (ns, gvr) without taking out a ref.
+func (f *Factory) Preload(ns, gvr string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.ensure(ns, gvr, f.fieldSelectors[nsGVRKey(ns, gvr)])
 }
 
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
+// ensure must be called with f.mx held.
+func (f *Factory) ensure(ns, gvr, fieldSel string) *informerEntry {
+	key := entryKey(ns, gvr, fieldSel)
+	if e, ok := f.informers[key]; ok {
+		return e
+	}
+
+	var tweak dynamicinformer.TweakListOptionsFunc
+	if fieldSel != "" {
+		tweak = func(opts *metav1.ListOptions) { opts.FieldSelector = fieldSel }
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fac := dynamicinformer.NewFilteredDynamicSharedInformerFactory(f.client.DynDialOrDie(), defaultResync, ns, tweak)
+	gi := fac.ForResource(toGVR(gvr))
+	gi.Informer().SetWatchErrorHandler(func(*cache.Reflector, error) {
+		f.mx.Lock()
+		defer f.mx.Unlock()
+		if e, ok := f.informers[key]; ok {
+			e.errs++
+		}
+	})
+	fac.Start(ctx.Done())
+
+	e := &informerEntry{gi: gi, cancel: cancel, lastUsed: time.Now()}
+	f.informers[key] = e
+
+	return e
 }
 
-func (f *Factory) Preload(ns, gvr string) {
-	_ = f.ForResource(ns, gvr)
+// release decrements the ref count for key. Once the last consumer has
+// released it, the informer is stopped and removed from the factory map
+// right away -- closing a view or switching namespaces shouldn't leave a
+// watch connection open on the apiserver waiting on the idle reaper.
+func (f *Factory) release(key string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	e, ok := f.informers[key]
+	if !ok {
+		return
+	}
+
+	e.refs--
+	if e.refs <= 0 {
+		f.evict(key)
+		return
+	}
+	e.lastUsed = time.Now()
+}
+
+// reapLoop evicts idle informers past their TTL, and LRU-evicts down to
+// MaxIdleInformers when more informers are idle than that.
+func (f *Factory) reapLoop() {
+	// Capture stopChan once under lock: Terminate() writes f.stopChan = nil
+	// under f.mx, and re-reading the field on every iteration here would
+	// race with that write.
+	f.mx.Lock()
+	stop := f.stopChan
+	f.mx.Unlock()
+
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			f.reapIdle()
+		}
+	}
 }
 
-func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
-	fact := f.ensureFactory(ns)
-	inf := fact.ForResource(toGVR(gvr))
-	fact.Start(f.stopChan)
+func (f *Factory) reapIdle() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
 
-	return inf
+	type idle struct {
+		key      string
+		lastUsed time.Time
+	}
+	var idles []idle
+	now := time.Now()
+	for key, e := range f.informers {
+		if e.refs > 0 {
+			continue
+		}
+		if now.Sub(e.lastUsed) >= f.idleTTL {
+			f.evict(key)
+			continue
+		}
+		idles = append(idles, idle{key: key, lastUsed: e.lastUsed})
+	}
+
+	for len(idles) > f.maxIdle {
+		oldest := 0
+		for i := 1; i < len(idles); i++ {
+			if idles[i].lastUsed.Before(idles[oldest].lastUsed) {
+				oldest = i
+			}
+		}
+		f.evict(idles[oldest].key)
+		idles = append(idles[:oldest], idles[oldest+1:]...)
+	}
 }
 
-func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
-	if f.isClusterWide() {
-		ns = allNamespaces
+// evict must be called with f.mx held.
+func (f *Factory) evict(key string) {
+	e, ok := f.informers[key]
+	if !ok {
+		return
 	}
-	if fac, ok := f.factories[ns]; ok {
-		return fac
+	e.cancel()
+	delete(f.informers, key)
+}
+
+// GVRStats reports informer health for a single GVR, surfaced by a debug view.
+type GVRStats struct {
+	Key          string
+	Active       bool
+	Refs         int
+	SyncDuration time.Duration
+	WatchErrors  int64
+}
+
+// Stats returns a point-in-time snapshot of every tracked informer.
+func (f *Factory) Stats() []GVRStats {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	stats := make([]GVRStats, 0, len(f.informers))
+	for key, e := range f.informers {
+		stats = append(stats, GVRStats{
+			Key:          key,
+			Active:       e.refs > 0,
+			Refs:         e.refs,
+			SyncDuration: e.synced,
+			WatchErrors:  e.errs,
+		})
 	}
 
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		f.client.DynDialOrDie(),
-		defaultResync,
-		ns,
-		nil,
-	)
-	f.preload(ns)
+	return stats
+}
+
+func entryKey(ns, gvr, fieldSel string) string {
+	return ns + "::" + gvr + "::" + fieldSel
+}
 
-	return f.factories[ns]
+func nsGVRKey(ns, gvr string) string {
+	return ns + "::" + gvr
 }
 
 func toGVR(gvr string) schema.GroupVersionResource {